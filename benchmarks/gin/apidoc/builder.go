@@ -0,0 +1,200 @@
+package apidoc
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// newLike allocates a new, zeroed *T for the same concrete type as
+// sample, so Handle can bind into it regardless of what Body was passed.
+func newLike(sample any) any {
+	t := reflect.TypeOf(sample)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return reflect.New(t).Interface()
+}
+
+// API collects routes registered through its builder methods into an
+// OpenAPI Spec, and mounts it (plus a Swagger UI) once MountDocs is called.
+type API struct {
+	router   gin.IRouter
+	validate *validator.Validate
+	spec     Spec
+}
+
+// New creates an API that registers routes on router and describes them
+// under the given title/version, mirroring the @title/@version
+// annotations in the original gin-server example.
+func New(router gin.IRouter, title, version string) *API {
+	return &API{
+		router:   router,
+		validate: validator.New(),
+		spec: Spec{
+			OpenAPI:    "3.0.3",
+			Info:       Info{Title: title, Version: version},
+			Paths:      map[string]PathItem{},
+			Components: Components{Schemas: map[string]Schema{}},
+		},
+	}
+}
+
+// GET, POST, PUT, PATCH, and DELETE start a RouteBuilder for the given
+// path and HTTP method.
+func (a *API) GET(path string) *RouteBuilder    { return a.method(http.MethodGet, path) }
+func (a *API) POST(path string) *RouteBuilder   { return a.method(http.MethodPost, path) }
+func (a *API) PUT(path string) *RouteBuilder    { return a.method(http.MethodPut, path) }
+func (a *API) PATCH(path string) *RouteBuilder  { return a.method(http.MethodPatch, path) }
+func (a *API) DELETE(path string) *RouteBuilder { return a.method(http.MethodDelete, path) }
+
+func (a *API) method(method, path string) *RouteBuilder {
+	return &RouteBuilder{
+		api:    a,
+		method: method,
+		path:   path,
+		op: Operation{
+			Responses: map[string]Response{},
+		},
+	}
+}
+
+// Spec returns the OpenAPI document assembled so far.
+func (a *API) Spec() Spec { return a.spec }
+
+// MountDocs serves the assembled OpenAPI document at /openapi.json and a
+// Swagger UI pointed at it at /docs.
+func (a *API) MountDocs(router gin.IRouter) {
+	router.GET("/openapi.json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, a.spec)
+	})
+	router.GET("/docs", func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIPage))
+	})
+}
+
+// RouteBuilder accumulates the documentation and validation for a single
+// route before Handle registers it.
+type RouteBuilder struct {
+	api    *API
+	method string
+	path   string
+	op     Operation
+	body   any
+}
+
+// Summary sets the route's one-line OpenAPI summary.
+func (b *RouteBuilder) Summary(s string) *RouteBuilder {
+	b.op.Summary = s
+	return b
+}
+
+// Params declares the path/query parameters accepted by the route.
+func (b *RouteBuilder) Params(params ...Parameter) *RouteBuilder {
+	b.op.Parameters = append(b.op.Parameters, params...)
+	return b
+}
+
+// Body declares the request body type. schema is bound with
+// c.ShouldBindJSON and validated with go-playground/validator before the
+// handler passed to Handle runs; validation failures short-circuit with
+// an RFC 7807 problem+json 422.
+func (b *RouteBuilder) Body(schema any) *RouteBuilder {
+	b.body = schema
+	b.op.RequestBody = &RequestBody{
+		Required: true,
+		Content: map[string]MediaType{
+			"application/json": {Schema: schemaFor(schema, b.api.spec.Components.Schemas)},
+		},
+	}
+	return b
+}
+
+// Response declares a possible response schema for the given status code.
+func (b *RouteBuilder) Response(status int, schema any) *RouteBuilder {
+	b.op.Responses[strconv.Itoa(status)] = Response{
+		Description: http.StatusText(status),
+		Content: map[string]MediaType{
+			"application/json": {Schema: schemaFor(schema, b.api.spec.Components.Schemas)},
+		},
+	}
+	return b
+}
+
+// Handle registers the route on the underlying router and records it in
+// the OpenAPI document. If Body was called, the request is bound and
+// validated before h runs.
+func (b *RouteBuilder) Handle(h gin.HandlerFunc) {
+	item, ok := b.api.spec.Paths[b.path]
+	if !ok {
+		item = PathItem{}
+	}
+	item[methodKey(b.method)] = b.op
+	b.api.spec.Paths[b.path] = item
+
+	wrapped := h
+	if b.body != nil {
+		bodyType := b.body
+		validate := b.api.validate
+		wrapped = func(c *gin.Context) {
+			target := newLike(bodyType)
+			if err := c.ShouldBindJSON(target); err != nil {
+				writeProblem(c, Problem{
+					Title:    "Malformed request body",
+					Status:   http.StatusBadRequest,
+					Detail:   err.Error(),
+					Instance: c.Request.URL.Path,
+				})
+				return
+			}
+			if err := validate.Struct(target); err != nil {
+				writeProblem(c, validationProblem(c, err))
+				return
+			}
+			c.Set(boundBodyKey, target)
+			h(c)
+		}
+	}
+
+	b.api.router.Handle(b.method, b.path, wrapped)
+}
+
+// boundBodyKey is the Gin context key under which Handle stores the
+// validated request body for handlers to retrieve with Bound.
+const boundBodyKey = "apidoc.body"
+
+// Bound retrieves the request body bound and validated by a preceding
+// Body() declaration.
+func Bound[T any](c *gin.Context) (T, bool) {
+	v, ok := c.Get(boundBodyKey)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	ptr, ok := v.(*T)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return *ptr, true
+}
+
+func methodKey(method string) string {
+	switch method {
+	case http.MethodGet:
+		return "get"
+	case http.MethodPost:
+		return "post"
+	case http.MethodPut:
+		return "put"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		return "delete"
+	default:
+		return method
+	}
+}
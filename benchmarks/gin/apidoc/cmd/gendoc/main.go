@@ -0,0 +1,34 @@
+// Command gendoc writes the server's OpenAPI document to openapi.json so
+// it can be committed and diffed like any other generated file. Run via
+// `go generate ./...` (see the //go:generate directive in
+// apidoc/generate.go).
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/apidoc"
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/routes"
+	"github.com/gin-gonic/gin"
+)
+
+func main() {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	api := routes.Register(router)
+
+	data, err := apidoc.MarshalSpec(api.Spec())
+	if err != nil {
+		log.Fatal("marshaling spec: ", err)
+	}
+
+	// go generate runs this from the apidoc package directory (where the
+	// //go:generate directive lives), one level below the module root.
+	out := filepath.Join("..", "openapi.json")
+	if err := os.WriteFile(out, append(data, '\n'), 0o644); err != nil {
+		log.Fatal("writing openapi.json: ", err)
+	}
+}
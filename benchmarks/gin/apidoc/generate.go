@@ -0,0 +1,12 @@
+package apidoc
+
+//go:generate go run ./cmd/gendoc
+
+import "encoding/json"
+
+// MarshalSpec renders spec as indented JSON, in the form written to
+// openapi.json by cmd/gendoc. /openapi.json serves the same document as
+// compact JSON.
+func MarshalSpec(spec Spec) ([]byte, error) {
+	return json.MarshalIndent(spec, "", "  ")
+}
@@ -0,0 +1,49 @@
+package apidoc
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// Problem is an RFC 7807 "application/problem+json" error body.
+type Problem struct {
+	Type     string            `json:"type,omitempty"`
+	Title    string            `json:"title"`
+	Status   int               `json:"status"`
+	Detail   string            `json:"detail,omitempty"`
+	Instance string            `json:"instance,omitempty"`
+	Errors   map[string]string `json:"errors,omitempty"`
+}
+
+const problemContentType = "application/problem+json"
+
+// writeProblem writes p as application/problem+json and aborts the
+// request with p.Status.
+func writeProblem(c *gin.Context, p Problem) {
+	c.Header("Content-Type", problemContentType)
+	c.AbortWithStatusJSON(p.Status, p)
+}
+
+// validationProblem turns a validator.ValidationErrors into a Problem
+// with one human-readable message per offending field.
+func validationProblem(c *gin.Context, err error) Problem {
+	p := Problem{
+		Title:    "Request validation failed",
+		Status:   http.StatusUnprocessableEntity,
+		Instance: c.Request.URL.Path,
+	}
+
+	verrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		p.Detail = err.Error()
+		return p
+	}
+
+	p.Errors = make(map[string]string, len(verrs))
+	for _, fe := range verrs {
+		p.Errors[fe.Field()] = fe.Error()
+	}
+	return p
+}
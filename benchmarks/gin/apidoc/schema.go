@@ -0,0 +1,96 @@
+package apidoc
+
+import (
+	"reflect"
+	"strings"
+)
+
+// schemaFor derives a JSON Schema for v by reflection, registering named
+// struct types in components so they can be shared by $ref instead of
+// inlined at every use site.
+func schemaFor(v any, components map[string]Schema) Schema {
+	if v == nil {
+		return Schema{}
+	}
+	return schemaForType(reflect.TypeOf(v), components)
+}
+
+func schemaForType(t reflect.Type, components map[string]Schema) Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return Schema{Type: "string"}
+	case reflect.Bool:
+		return Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{Type: "number", Format: "double"}
+	case reflect.Slice, reflect.Array:
+		item := schemaForType(t.Elem(), components)
+		return Schema{Type: "array", Items: &item}
+	case reflect.Struct:
+		name := t.Name()
+		if name != "" {
+			if _, ok := components[name]; ok {
+				return Schema{Ref: "#/components/schemas/" + name}
+			}
+			components[name] = Schema{} // reserve the name to break recursive cycles
+			components[name] = structSchema(t, components)
+			return Schema{Ref: "#/components/schemas/" + name}
+		}
+		return structSchema(t, components)
+	default:
+		return Schema{Type: "object"}
+	}
+}
+
+func structSchema(t reflect.Type, components map[string]Schema) Schema {
+	schema := Schema{Type: "object", Properties: map[string]Schema{}}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		jsonName, omit := jsonFieldName(field)
+		if omit {
+			continue
+		}
+
+		schema.Properties[jsonName] = schemaForType(field.Type, components)
+
+		if isRequired(field) {
+			schema.Required = append(schema.Required, jsonName)
+		}
+	}
+
+	return schema
+}
+
+func jsonFieldName(field reflect.StructField) (name string, omit bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		return parts[0], false
+	}
+	return field.Name, false
+}
+
+func isRequired(field reflect.StructField) bool {
+	validateTag := field.Tag.Get("validate")
+	for _, rule := range strings.Split(validateTag, ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+// Package apidoc lets handlers be registered through a typed builder that
+// both mounts the Gin route and records enough information to emit an
+// OpenAPI 3.0 document at /openapi.json, with a Swagger UI at /docs.
+package apidoc
+
+// Spec is a minimal OpenAPI 3.0 document: just enough of the schema to
+// describe the routes this package knows how to register.
+type Spec struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info is the OpenAPI document's top-level `info` object, populated from
+// the @title/@version annotations callers pass to New.
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem maps HTTP methods to the Operation registered for that path.
+type PathItem map[string]Operation
+
+// Operation describes a single route: its parameters, request body
+// schema, and possible responses.
+type Operation struct {
+	Summary     string              `json:"summary,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter describes a path, query, or header parameter.
+type Parameter struct {
+	Name     string `json:"name"`
+	In       string `json:"in"` // "path", "query", or "header"
+	Required bool   `json:"required"`
+	Schema   Schema `json:"schema"`
+}
+
+// RequestBody describes the expected JSON request body.
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response describes one possible response for an Operation, keyed by
+// status code in the enclosing map.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType wraps the schema for a single content type, e.g.
+// application/json.
+type MediaType struct {
+	Schema Schema `json:"schema"`
+}
+
+// Schema is a JSON Schema subset, derived from a Go value via reflection
+// in schemaFor.
+type Schema struct {
+	Type       string            `json:"type,omitempty"`
+	Format     string            `json:"format,omitempty"`
+	Items      *Schema           `json:"items,omitempty"`
+	Properties map[string]Schema `json:"properties,omitempty"`
+	Required   []string          `json:"required,omitempty"`
+	Ref        string            `json:"$ref,omitempty"`
+}
+
+// Components holds named, reusable schemas referenced by $ref.
+type Components struct {
+	Schemas map[string]Schema `json:"schemas"`
+}
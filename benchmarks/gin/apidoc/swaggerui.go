@@ -0,0 +1,24 @@
+package apidoc
+
+// swaggerUIPage renders Swagger UI against /openapi.json via the
+// swagger-ui-dist CDN bundle, avoiding the need to vendor the UI assets.
+const swaggerUIPage = `<!DOCTYPE html>
+<html>
+<head>
+    <title>API docs</title>
+    <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/swagger-ui-dist/swagger-ui.css">
+</head>
+<body>
+    <div id="swagger-ui"></div>
+    <script src="https://cdn.jsdelivr.net/npm/swagger-ui-dist/swagger-ui-bundle.js"></script>
+    <script>
+        window.onload = function() {
+            SwaggerUIBundle({
+                url: "/openapi.json",
+                dom_id: "#swagger-ui",
+            });
+        };
+    </script>
+</body>
+</html>
+`
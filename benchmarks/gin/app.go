@@ -1,26 +1,105 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/auth"
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/config"
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/health"
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/metrics"
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/middleware"
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/routes"
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/web"
 	"github.com/gin-gonic/gin"
 )
 
 func main() {
-	// Disable console color and debug logs.
-	gin.SetMode(gin.ReleaseMode)
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		log.Fatal("loading config: ", err)
+	}
+
+	gin.SetMode(cfg.GinMode)
+
+	router := middleware.Build(cfg.Middleware)
+	if len(cfg.TrustedProxies) > 0 {
+		if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+			log.Fatal("setting trusted proxies: ", err)
+		}
+	}
 
-	router := gin.New()
+	router.Use(metrics.Middleware())
 
-	router.GET("/", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{
-			"message": "Hello, World!",
-		})
+	api := routes.Register(router)
+	api.MountDocs(router)
+
+	authService, err := buildAuthService(cfg)
+	if err != nil {
+		log.Fatal("configuring auth: ", err)
+	}
+	authService.Register(router.Group("/auth"))
+
+	protected := router.Group("/api", authService.RequireJWT("user"))
+	protected.GET("/me", func(c *gin.Context) {
+		claims, _ := auth.ClaimsFromContext(c)
+		c.JSON(http.StatusOK, gin.H{"subject": claims.Subject, "scopes": claims.Scopes})
 	})
 
-	// Run the server on 0.0.0.0:8000.
-	log.Println("Starting Gin server on :8000...")
-	if err := router.Run(":8000"); err != nil {
-		log.Fatal("Server failed to run: ", err)
+	readiness := health.NewRegistry(2 * time.Second)
+	health.Register(router, readiness)
+	metrics.Register(router)
+
+	if err := web.Register(router, web.Options{
+		Debug:        cfg.Web.Debug,
+		TemplatesDir: cfg.Web.TemplatesDir,
+		StaticDir:    cfg.Web.StaticDir,
+		BuildInfo:    cfg.Web.BuildInfo,
+	}); err != nil {
+		log.Fatal("registering web assets: ", err)
 	}
-}
\ No newline at end of file
+
+	srv := &http.Server{
+		Addr:           cfg.HTTPAddr,
+		Handler:        router,
+		ReadTimeout:    cfg.ReadTimeout,
+		WriteTimeout:   cfg.WriteTimeout,
+		IdleTimeout:    cfg.IdleTimeout,
+		MaxHeaderBytes: cfg.MaxHeaderBytes,
+	}
+
+	go func() {
+		log.Printf("Starting Gin server on %s...", cfg.HTTPAddr)
+
+		var err error
+		if cfg.TLSCert != "" && cfg.TLSKey != "" {
+			err = srv.ListenAndServeTLS(cfg.TLSCert, cfg.TLSKey)
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("Server failed to run: ", err)
+		}
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	log.Println("Shutting down server...")
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownGrace)
+	defer cancel()
+
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Fatal("Server forced to shutdown: ", err)
+	}
+
+	log.Println("Server exited")
+}
@@ -0,0 +1,24 @@
+// Package auth provides JWT-based authentication for the gin benchmark
+// server: a RequireJWT middleware, login/refresh/logout handlers backed
+// by a pluggable refresh-token store, and replay protection via a jti
+// deny-list.
+package auth
+
+import "github.com/golang-jwt/jwt/v5"
+
+// Claims is the JWT payload issued for access tokens. Scopes drives
+// RequireJWT's authorization check.
+type Claims struct {
+	jwt.RegisteredClaims
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// HasScope reports whether c includes scope.
+func (c Claims) HasScope(scope string) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
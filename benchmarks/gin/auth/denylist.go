@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DenyList tracks revoked/used token IDs (jti) for replay protection.
+// Entries expire after the token's own lifetime, so the list never grows
+// unbounded.
+type DenyList interface {
+	// Add marks jti as denied until it expires ttl from now.
+	Add(ctx context.Context, jti string, ttl time.Duration) error
+	// Contains reports whether jti is currently denied.
+	Contains(ctx context.Context, jti string) (bool, error)
+}
+
+// MemoryDenyList is an in-process DenyList backed by a map, suitable for
+// single-instance deployments and tests.
+type MemoryDenyList struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+}
+
+// NewMemoryDenyList creates an empty MemoryDenyList.
+func NewMemoryDenyList() *MemoryDenyList {
+	return &MemoryDenyList{entries: make(map[string]time.Time)}
+}
+
+// Add implements DenyList.
+func (d *MemoryDenyList) Add(_ context.Context, jti string, ttl time.Duration) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries[jti] = time.Now().Add(ttl)
+	return nil
+}
+
+// Contains implements DenyList.
+func (d *MemoryDenyList) Contains(_ context.Context, jti string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	expiry, ok := d.entries[jti]
+	if !ok {
+		return false, nil
+	}
+	if time.Now().After(expiry) {
+		delete(d.entries, jti)
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisDenyList is a DenyList backed by Redis, for multi-instance
+// deployments that need to share revocations.
+type RedisDenyList struct {
+	client    *redis.Client
+	keyPrefix string
+}
+
+// NewRedisDenyList creates a RedisDenyList that namespaces its keys under
+// keyPrefix (e.g. "mitsuki:jti:").
+func NewRedisDenyList(client *redis.Client, keyPrefix string) *RedisDenyList {
+	return &RedisDenyList{client: client, keyPrefix: keyPrefix}
+}
+
+// Add implements DenyList.
+func (d *RedisDenyList) Add(ctx context.Context, jti string, ttl time.Duration) error {
+	return d.client.Set(ctx, d.keyPrefix+jti, "1", ttl).Err()
+}
+
+// Contains implements DenyList.
+func (d *RedisDenyList) Contains(ctx context.Context, jti string) (bool, error) {
+	n, err := d.client.Exists(ctx, d.keyPrefix+jti).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,7 @@
+package auth
+
+import "errors"
+
+// errInvalidCredentials is returned by Authenticators when a
+// username/password pair doesn't match.
+var errInvalidCredentials = errors.New("auth: invalid credentials")
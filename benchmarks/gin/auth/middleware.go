@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// claimsKey is the Gin context key RequireJWT stores verified claims
+// under; retrieve them with ClaimsFromContext.
+const claimsKey = "auth.claims"
+
+// RequireJWT returns a middleware that verifies the bearer token on the
+// request, rejecting with 401 if it's missing, malformed, expired, or
+// denied, and with 403 if the token lacks any of scopes. On success, the
+// verified Claims are stored in the context.
+func (s *Service) RequireJWT(scopes ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := bearerToken(c.GetHeader("Authorization"))
+		if token == "" {
+			abortUnauthorized(c, "missing bearer token")
+			return
+		}
+
+		claims, err := s.issuer.Verify(token)
+		if err != nil {
+			abortUnauthorized(c, "invalid token")
+			return
+		}
+
+		denied, err := s.denyList.Contains(c.Request.Context(), claims.ID)
+		if err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if denied {
+			abortUnauthorized(c, "token revoked")
+			return
+		}
+
+		for _, scope := range scopes {
+			if !claims.HasScope(scope) {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "missing required scope: " + scope})
+				return
+			}
+		}
+
+		c.Set(claimsKey, claims)
+		c.Next()
+	}
+}
+
+// ClaimsFromContext retrieves the Claims stored by RequireJWT.
+func ClaimsFromContext(c *gin.Context) (*Claims, bool) {
+	v, ok := c.Get(claimsKey)
+	if !ok {
+		return nil, false
+	}
+	claims, ok := v.(*Claims)
+	return claims, ok
+}
+
+func bearerToken(header string) string {
+	const prefix = "Bearer "
+	if !strings.HasPrefix(header, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(header, prefix)
+}
+
+func abortUnauthorized(c *gin.Context, reason string) {
+	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": reason})
+}
@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// LoadRSAKeyPair reads and parses a PEM-encoded RSA private key and its
+// matching public key, for use with NewRS256Issuer.
+func LoadRSAKeyPair(privateKeyPath, publicKeyPath string) (*rsa.PrivateKey, *rsa.PublicKey, error) {
+	privPEM, err := os.ReadFile(privateKeyPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("auth: reading RSA private key: %w", err)
+	}
+	priv, err := jwt.ParseRSAPrivateKeyFromPEM(privPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("auth: parsing RSA private key: %w", err)
+	}
+
+	pubPEM, err := os.ReadFile(publicKeyPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("auth: reading RSA public key: %w", err)
+	}
+	pub, err := jwt.ParseRSAPublicKeyFromPEM(pubPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("auth: parsing RSA public key: %w", err)
+	}
+
+	return priv, pub, nil
+}
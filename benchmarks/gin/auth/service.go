@@ -0,0 +1,33 @@
+package auth
+
+import "time"
+
+// Authenticator checks a username/password pair and returns the scopes
+// to issue for that subject on success.
+type Authenticator interface {
+	Authenticate(username, password string) (subject string, scopes []string, err error)
+}
+
+// Service wires together token issuance, the refresh-token store, the
+// jti deny-list, and credential verification behind RequireJWT and the
+// /auth/* handlers.
+type Service struct {
+	issuer        *Issuer
+	authenticator Authenticator
+	refreshStore  RefreshStore
+	denyList      DenyList
+	refreshTTL    time.Duration
+}
+
+// NewService creates a Service. refreshTTL is the lifetime given to each
+// refresh token it issues; access-token jtis revoked on logout are denied
+// for the rest of that access token's own lifetime.
+func NewService(issuer *Issuer, authenticator Authenticator, refreshStore RefreshStore, denyList DenyList, refreshTTL time.Duration) *Service {
+	return &Service{
+		issuer:        issuer,
+		authenticator: authenticator,
+		refreshStore:  refreshStore,
+		denyList:      denyList,
+		refreshTTL:    refreshTTL,
+	}
+}
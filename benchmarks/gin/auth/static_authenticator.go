@@ -0,0 +1,25 @@
+package auth
+
+// StaticAuthenticator authenticates against a fixed, in-memory set of
+// username/password pairs, each granted the same scopes. It exists for
+// local development and tests; production deployments should supply an
+// Authenticator backed by a real user store.
+type StaticAuthenticator struct {
+	users  map[string]string
+	scopes []string
+}
+
+// NewStaticAuthenticator creates a StaticAuthenticator from a
+// username-to-password map, granting scopes to every successful login.
+func NewStaticAuthenticator(users map[string]string, scopes []string) *StaticAuthenticator {
+	return &StaticAuthenticator{users: users, scopes: scopes}
+}
+
+// Authenticate implements Authenticator.
+func (a *StaticAuthenticator) Authenticate(username, password string) (string, []string, error) {
+	want, ok := a.users[username]
+	if !ok || want != password {
+		return "", nil, errInvalidCredentials
+	}
+	return username, a.scopes, nil
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrRefreshTokenNotFound is returned by RefreshStore.Rotate when the
+// token isn't known, whether because it never existed, already rotated,
+// or expired.
+var ErrRefreshTokenNotFound = errors.New("auth: refresh token not found")
+
+// RefreshRecord is what a RefreshStore keeps per refresh token. Scopes is
+// carried along so a rotated refresh token reissues an access token with
+// the same scopes the client originally logged in with.
+type RefreshRecord struct {
+	Subject   string
+	Scopes    []string
+	ExpiresAt time.Time
+}
+
+// RefreshStore persists rotating refresh tokens. Rotate must be atomic:
+// a refresh token can be redeemed at most once.
+type RefreshStore interface {
+	// Save stores token for subject, expiring at record.ExpiresAt.
+	Save(ctx context.Context, token string, record RefreshRecord) error
+	// Rotate atomically deletes token and returns its record, so it can't
+	// be redeemed twice even under concurrent requests.
+	Rotate(ctx context.Context, token string) (RefreshRecord, error)
+	// Revoke deletes token outright, e.g. on logout.
+	Revoke(ctx context.Context, token string) error
+}
+
+// MemoryRefreshStore is an in-process RefreshStore, suitable for
+// single-instance deployments and tests.
+type MemoryRefreshStore struct {
+	mu      sync.Mutex
+	records map[string]RefreshRecord
+}
+
+// NewMemoryRefreshStore creates an empty MemoryRefreshStore.
+func NewMemoryRefreshStore() *MemoryRefreshStore {
+	return &MemoryRefreshStore{records: make(map[string]RefreshRecord)}
+}
+
+// Save implements RefreshStore.
+func (s *MemoryRefreshStore) Save(_ context.Context, token string, record RefreshRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[token] = record
+	return nil
+}
+
+// Rotate implements RefreshStore.
+func (s *MemoryRefreshStore) Rotate(_ context.Context, token string) (RefreshRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[token]
+	if !ok {
+		return RefreshRecord{}, ErrRefreshTokenNotFound
+	}
+	delete(s.records, token)
+
+	if time.Now().After(record.ExpiresAt) {
+		return RefreshRecord{}, ErrRefreshTokenNotFound
+	}
+	return record, nil
+}
+
+// Revoke implements RefreshStore.
+func (s *MemoryRefreshStore) Revoke(_ context.Context, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, token)
+	return nil
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisRefreshStore is a RefreshStore backed by Redis, for multi-instance
+// deployments. Rotate relies on GETDEL for its single-redemption guarantee.
+type RedisRefreshStore struct {
+	client    *redis.Client
+	keyPrefix string
+}
+
+// NewRedisRefreshStore creates a RedisRefreshStore that namespaces its
+// keys under keyPrefix (e.g. "mitsuki:refresh:").
+func NewRedisRefreshStore(client *redis.Client, keyPrefix string) *RedisRefreshStore {
+	return &RedisRefreshStore{client: client, keyPrefix: keyPrefix}
+}
+
+// Save implements RefreshStore.
+func (s *RedisRefreshStore) Save(ctx context.Context, token string, record RefreshRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	ttl := time.Until(record.ExpiresAt)
+	return s.client.Set(ctx, s.keyPrefix+token, data, ttl).Err()
+}
+
+// Rotate implements RefreshStore.
+func (s *RedisRefreshStore) Rotate(ctx context.Context, token string) (RefreshRecord, error) {
+	data, err := s.client.GetDel(ctx, s.keyPrefix+token).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return RefreshRecord{}, ErrRefreshTokenNotFound
+	}
+	if err != nil {
+		return RefreshRecord{}, err
+	}
+
+	var record RefreshRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return RefreshRecord{}, err
+	}
+	if time.Now().After(record.ExpiresAt) {
+		return RefreshRecord{}, ErrRefreshTokenNotFound
+	}
+	return record, nil
+}
+
+// Revoke implements RefreshStore.
+func (s *RedisRefreshStore) Revoke(ctx context.Context, token string) error {
+	return s.client.Del(ctx, s.keyPrefix+token).Err()
+}
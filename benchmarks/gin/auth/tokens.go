@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"time"
+
+	"github.com/MicahParks/keyfunc/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Issuer signs and verifies access tokens. Exactly one of hmacSecret or
+// rsaPrivate is set, matching AuthConfig.Algorithm; verification instead
+// uses a JWKS-backed keyfunc when JWKSURL is configured, so a service can
+// verify tokens it doesn't itself sign.
+type Issuer struct {
+	issuer        string
+	accessTTL     time.Duration
+	signingMethod jwt.SigningMethod
+
+	hmacSecret []byte
+	rsaPrivate *rsa.PrivateKey
+	rsaPublic  *rsa.PublicKey
+	jwks       keyfunc.Keyfunc
+}
+
+// NewHS256Issuer creates an Issuer that signs and verifies tokens with a
+// shared HMAC secret. secret must be non-empty, or every token this
+// Issuer signs would be trivially forgeable.
+func NewHS256Issuer(issuerName, secret string, accessTTL time.Duration) (*Issuer, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("auth: HS256 requires a non-empty secret")
+	}
+	return &Issuer{
+		issuer:        issuerName,
+		accessTTL:     accessTTL,
+		signingMethod: jwt.SigningMethodHS256,
+		hmacSecret:    []byte(secret),
+	}, nil
+}
+
+// NewRS256Issuer creates an Issuer that signs with priv and verifies with
+// pub. If jwksURL is non-empty, verification instead fetches keys from
+// that JWKS endpoint, so peers can verify tokens without sharing priv.
+func NewRS256Issuer(issuerName string, priv *rsa.PrivateKey, pub *rsa.PublicKey, jwksURL string, accessTTL time.Duration) (*Issuer, error) {
+	i := &Issuer{
+		issuer:        issuerName,
+		accessTTL:     accessTTL,
+		signingMethod: jwt.SigningMethodRS256,
+		rsaPrivate:    priv,
+		rsaPublic:     pub,
+	}
+
+	if jwksURL != "" {
+		jwks, err := keyfunc.NewDefaultCtx(context.Background(), []string{jwksURL})
+		if err != nil {
+			return nil, fmt.Errorf("auth: fetching JWKS from %s: %w", jwksURL, err)
+		}
+		i.jwks = jwks
+	}
+
+	return i, nil
+}
+
+// IssueAccessToken signs a new access token for subject with the given
+// scopes, returning the signed token and its jti.
+func (i *Issuer) IssueAccessToken(subject string, scopes []string) (token string, jti string, err error) {
+	jti = uuid.NewString()
+	now := time.Now()
+
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    i.issuer,
+			ID:        jti,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(i.accessTTL)),
+		},
+		Scopes: scopes,
+	}
+
+	signed, err := jwt.NewWithClaims(i.signingMethod, claims).SignedString(i.signingKey())
+	if err != nil {
+		return "", "", fmt.Errorf("auth: signing access token: %w", err)
+	}
+	return signed, jti, nil
+}
+
+func (i *Issuer) signingKey() any {
+	if i.hmacSecret != nil {
+		return i.hmacSecret
+	}
+	return i.rsaPrivate
+}
+
+// Verify parses and validates a signed access token, returning its claims.
+func (i *Issuer) Verify(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, i.keyFunc, jwt.WithValidMethods([]string{i.signingMethod.Alg()}))
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("auth: token invalid")
+	}
+	return claims, nil
+}
+
+func (i *Issuer) keyFunc(token *jwt.Token) (any, error) {
+	if i.jwks != nil {
+		return i.jwks.Keyfunc(token)
+	}
+	if i.hmacSecret != nil {
+		return i.hmacSecret, nil
+	}
+	return i.rsaPublic, nil
+}
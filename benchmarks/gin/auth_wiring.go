@@ -0,0 +1,79 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/auth"
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/config"
+	"github.com/redis/go-redis/v9"
+)
+
+// buildAuthService wires an auth.Service from cfg: an HS256 or RS256
+// issuer depending on cfg.Auth.Algorithm, and a Redis-backed refresh
+// store/deny-list when cfg.Auth.RedisAddr is set, falling back to
+// in-memory implementations otherwise.
+func buildAuthService(cfg config.Config) (*auth.Service, error) {
+	issuer, err := buildIssuer(cfg.Auth)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshStore, denyList := buildStores(cfg.Auth)
+
+	return auth.NewService(
+		issuer,
+		auth.NewStaticAuthenticator(map[string]string{"demo": "demo"}, []string{"user"}),
+		refreshStore,
+		denyList,
+		cfg.Auth.RefreshTokenTTL,
+	), nil
+}
+
+func buildIssuer(cfg config.AuthConfig) (*auth.Issuer, error) {
+	switch cfg.Algorithm {
+	case "", "HS256":
+		secret := cfg.HMACSecret
+		if secret == "" {
+			generated, err := generateEphemeralSecret()
+			if err != nil {
+				return nil, fmt.Errorf("auth: generating ephemeral HMAC secret: %w", err)
+			}
+			secret = generated
+			log.Print("auth: AUTH_HMAC_SECRET is not set; signing with a random secret generated " +
+				"for this process only. Tokens won't verify across restarts or replicas. Set " +
+				"AUTH_HMAC_SECRET before running this in production.")
+		}
+		return auth.NewHS256Issuer(cfg.Issuer, secret, cfg.AccessTokenTTL)
+	case "RS256":
+		priv, pub, err := auth.LoadRSAKeyPair(cfg.RSAPrivateKeyPath, cfg.RSAPublicKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		return auth.NewRS256Issuer(cfg.Issuer, priv, pub, cfg.JWKSURL, cfg.AccessTokenTTL)
+	default:
+		return nil, fmt.Errorf("auth: unsupported algorithm %q", cfg.Algorithm)
+	}
+}
+
+// generateEphemeralSecret returns a random base64-encoded secret suitable
+// for signing tokens for the lifetime of this process only.
+func generateEphemeralSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func buildStores(cfg config.AuthConfig) (auth.RefreshStore, auth.DenyList) {
+	if cfg.RedisAddr == "" {
+		return auth.NewMemoryRefreshStore(), auth.NewMemoryDenyList()
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: cfg.RedisAddr})
+	return auth.NewRedisRefreshStore(client, "mitsuki:refresh:"),
+		auth.NewRedisDenyList(client, "mitsuki:jti:")
+}
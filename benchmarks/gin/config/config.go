@@ -0,0 +1,239 @@
+// Package config loads runtime configuration for the gin benchmark server
+// from an optional YAML file, environment variables, and command-line
+// flags, in that order of increasing precedence.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds everything main needs to stand up an *http.Server wrapping
+// the Gin engine.
+type Config struct {
+	HTTPAddr       string        `yaml:"http_addr"`
+	GinMode        string        `yaml:"gin_mode"`
+	ReadTimeout    time.Duration `yaml:"read_timeout"`
+	WriteTimeout   time.Duration `yaml:"write_timeout"`
+	IdleTimeout    time.Duration `yaml:"idle_timeout"`
+	MaxHeaderBytes int           `yaml:"max_header_bytes"`
+	TLSCert        string        `yaml:"tls_cert"`
+	TLSKey         string        `yaml:"tls_key"`
+	TrustedProxies []string      `yaml:"trusted_proxies"`
+	ShutdownGrace  time.Duration `yaml:"shutdown_grace"`
+
+	Middleware MiddlewareConfig `yaml:"middleware"`
+	Web        WebConfig        `yaml:"web"`
+	Auth       AuthConfig       `yaml:"auth"`
+}
+
+// AuthConfig configures JWT signing/verification and token lifetimes for
+// the auth package.
+type AuthConfig struct {
+	// Algorithm is "HS256" or "RS256".
+	Algorithm string `yaml:"algorithm"`
+
+	// HMACSecret is the shared key used when Algorithm is HS256. If left
+	// empty, main generates a random secret for the life of the process
+	// (logging a warning), so tokens won't verify across restarts or
+	// replicas; set this explicitly outside of local development.
+	HMACSecret string `yaml:"hmac_secret"`
+
+	// RSAPrivateKeyPath and RSAPublicKeyPath are PEM file paths used when
+	// Algorithm is RS256.
+	RSAPrivateKeyPath string `yaml:"rsa_private_key_path"`
+	RSAPublicKeyPath  string `yaml:"rsa_public_key_path"`
+
+	// JWKSURL, if set, verifies RS256 tokens against a remote JWKS
+	// instead of RSAPublicKeyPath.
+	JWKSURL string `yaml:"jwks_url"`
+
+	Issuer          string        `yaml:"issuer"`
+	AccessTokenTTL  time.Duration `yaml:"access_token_ttl"`
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl"`
+
+	// RedisAddr, if set, backs the refresh-token store and jti deny-list
+	// with Redis instead of in-memory maps.
+	RedisAddr string `yaml:"redis_addr"`
+}
+
+// WebConfig configures the web package's template/static serving.
+type WebConfig struct {
+	// Debug enables loading templates/static assets from disk with hot
+	// reload, instead of the embedded copies used in release builds.
+	Debug        bool   `yaml:"debug"`
+	TemplatesDir string `yaml:"templates_dir"`
+	StaticDir    string `yaml:"static_dir"`
+	BuildInfo    string `yaml:"build_info"`
+}
+
+// MiddlewareConfig toggles and tunes the middleware stack assembled by the
+// middleware package. Each piece can be disabled independently.
+type MiddlewareConfig struct {
+	RequestLogging bool `yaml:"request_logging"`
+
+	CORSEnabled        bool     `yaml:"cors_enabled"`
+	CORSAllowedOrigins []string `yaml:"cors_allowed_origins"`
+
+	SecurityHeadersEnabled bool `yaml:"security_headers_enabled"`
+
+	RateLimitEnabled bool          `yaml:"rate_limit_enabled"`
+	RateLimitRPS     float64       `yaml:"rate_limit_rps"`
+	RateLimitBurst   int           `yaml:"rate_limit_burst"`
+	RateLimitIdleTTL time.Duration `yaml:"rate_limit_idle_ttl"`
+}
+
+// Default returns the base configuration: the address and Gin mode the
+// server used to be hardcoded with (":8000", release), plus default
+// server timeouts, an enabled middleware stack (request logging, security
+// headers, rate limiting), and web and auth settings.
+func Default() Config {
+	return Config{
+		HTTPAddr:       ":8000",
+		GinMode:        "release",
+		ReadTimeout:    5 * time.Second,
+		WriteTimeout:   10 * time.Second,
+		IdleTimeout:    120 * time.Second,
+		MaxHeaderBytes: 1 << 20,
+		ShutdownGrace:  15 * time.Second,
+		Middleware: MiddlewareConfig{
+			RequestLogging:         true,
+			CORSEnabled:            false,
+			SecurityHeadersEnabled: true,
+			RateLimitEnabled:       true,
+			RateLimitRPS:           10,
+			RateLimitBurst:         20,
+			RateLimitIdleTTL:       5 * time.Minute,
+		},
+		Web: WebConfig{
+			Debug:        false,
+			TemplatesDir: "web/templates",
+			StaticDir:    "web/static",
+		},
+		Auth: AuthConfig{
+			Algorithm:       "HS256",
+			Issuer:          "mitsuki-gin",
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: 7 * 24 * time.Hour,
+		},
+	}
+}
+
+// Load builds a Config from Default(), then applies overrides from an
+// optional YAML file (-config / CONFIG_FILE), then environment variables,
+// then command-line flags, in that order. args should be os.Args[1:].
+func Load(args []string) (Config, error) {
+	cfg := Default()
+
+	fs := flag.NewFlagSet("mitsuki-gin", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "path to an optional YAML config file")
+	httpAddr := fs.String("http-addr", "", "address to listen on, e.g. :8000")
+	ginMode := fs.String("gin-mode", "", "gin mode: debug, release, or test")
+	tlsCert := fs.String("tls-cert", "", "path to a TLS certificate")
+	tlsKey := fs.String("tls-key", "", "path to a TLS private key")
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	if *configFile != "" {
+		if err := applyYAMLFile(&cfg, *configFile); err != nil {
+			return Config{}, err
+		}
+	}
+
+	if err := applyEnv(&cfg); err != nil {
+		return Config{}, err
+	}
+
+	if *httpAddr != "" {
+		cfg.HTTPAddr = *httpAddr
+	}
+	if *ginMode != "" {
+		cfg.GinMode = *ginMode
+	}
+	if *tlsCert != "" {
+		cfg.TLSCert = *tlsCert
+	}
+	if *tlsKey != "" {
+		cfg.TLSKey = *tlsKey
+	}
+
+	return cfg, nil
+}
+
+func applyYAMLFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config: reading %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return fmt.Errorf("config: parsing %s: %w", path, err)
+	}
+	return nil
+}
+
+func applyEnv(cfg *Config) error {
+	if v := os.Getenv("HTTP_ADDR"); v != "" {
+		cfg.HTTPAddr = v
+	}
+	if v := os.Getenv("GIN_MODE"); v != "" {
+		cfg.GinMode = v
+	}
+	if v := os.Getenv("READ_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("config: READ_TIMEOUT: %w", err)
+		}
+		cfg.ReadTimeout = d
+	}
+	if v := os.Getenv("WRITE_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("config: WRITE_TIMEOUT: %w", err)
+		}
+		cfg.WriteTimeout = d
+	}
+	if v := os.Getenv("IDLE_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("config: IDLE_TIMEOUT: %w", err)
+		}
+		cfg.IdleTimeout = d
+	}
+	if v := os.Getenv("MAX_HEADER_BYTES"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("config: MAX_HEADER_BYTES: %w", err)
+		}
+		cfg.MaxHeaderBytes = n
+	}
+	if v := os.Getenv("TLS_CERT"); v != "" {
+		cfg.TLSCert = v
+	}
+	if v := os.Getenv("TLS_KEY"); v != "" {
+		cfg.TLSKey = v
+	}
+	if v := os.Getenv("SHUTDOWN_GRACE"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("config: SHUTDOWN_GRACE: %w", err)
+		}
+		cfg.ShutdownGrace = d
+	}
+	if v := os.Getenv("AUTH_HMAC_SECRET"); v != "" {
+		cfg.Auth.HMACSecret = v
+	}
+	if v := os.Getenv("WEB_DEBUG"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("config: WEB_DEBUG: %w", err)
+		}
+		cfg.Web.Debug = b
+	}
+	return nil
+}
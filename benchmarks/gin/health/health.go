@@ -0,0 +1,106 @@
+// Package health provides liveness and readiness endpoints for the gin
+// benchmark server. Liveness reports whether the process is up; readiness
+// aggregates a set of registered Checkers so load balancers can hold back
+// traffic until dependencies are actually available.
+package health
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Checker is implemented by anything that can report its own health, e.g.
+// a database connection pool or an upstream client.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+// Registry aggregates Checkers for the /readyz endpoint.
+type Registry struct {
+	mu       sync.RWMutex
+	checkers []Checker
+	timeout  time.Duration
+}
+
+// NewRegistry creates a Registry that runs each check with the given
+// per-check timeout.
+func NewRegistry(timeout time.Duration) *Registry {
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	return &Registry{timeout: timeout}
+}
+
+// Register adds a Checker to the registry.
+func (r *Registry) Register(c Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checkers = append(r.checkers, c)
+}
+
+type checkResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type report struct {
+	Status string        `json:"status"`
+	Checks []checkResult `json:"checks"`
+}
+
+func (r *Registry) run(ctx context.Context) report {
+	r.mu.RLock()
+	checkers := make([]Checker, len(r.checkers))
+	copy(checkers, r.checkers)
+	r.mu.RUnlock()
+
+	rep := report{Status: "ok", Checks: make([]checkResult, len(checkers))}
+
+	var wg sync.WaitGroup
+	for i, c := range checkers {
+		wg.Add(1)
+		go func(i int, c Checker) {
+			defer wg.Done()
+			cctx, cancel := context.WithTimeout(ctx, r.timeout)
+			defer cancel()
+
+			res := checkResult{Name: c.Name(), Status: "ok"}
+			if err := c.Check(cctx); err != nil {
+				res.Status = "fail"
+				res.Error = err.Error()
+			}
+			rep.Checks[i] = res
+		}(i, c)
+	}
+	wg.Wait()
+
+	for _, res := range rep.Checks {
+		if res.Status != "ok" {
+			rep.Status = "fail"
+			break
+		}
+	}
+	return rep
+}
+
+// Register mounts /healthz and /readyz on the given router.
+func Register(router gin.IRouter, registry *Registry) {
+	router.GET("/healthz", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"status": "ok"})
+	})
+
+	router.GET("/readyz", func(c *gin.Context) {
+		rep := registry.run(c.Request.Context())
+		status := http.StatusOK
+		if rep.Status != "ok" {
+			status = http.StatusServiceUnavailable
+		}
+		c.JSON(status, rep)
+	})
+}
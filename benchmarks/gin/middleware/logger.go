@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader is the header used to propagate the request ID to and
+// from the client.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestLogger returns a middleware that assigns a request ID (reusing
+// one supplied by the client, if any), stores it in the Gin context, and
+// logs each completed request as structured JSON via log/slog.
+func RequestLogger() gin.HandlerFunc {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		c.Set("request_id", requestID)
+		c.Writer.Header().Set(RequestIDHeader, requestID)
+
+		start := time.Now()
+		c.Next()
+
+		logger.Info("request",
+			"request_id", requestID,
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", c.Writer.Status(),
+			"duration", time.Since(start),
+			"client_ip", c.ClientIP(),
+		)
+	}
+}
@@ -0,0 +1,42 @@
+// Package middleware assembles the Gin middleware stack for the server:
+// recovery, structured request logging, CORS, security headers, and a
+// per-IP rate limiter. Recovery is always attached; each of the other
+// pieces is independently toggleable via config.MiddlewareConfig.
+package middleware
+
+import (
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/config"
+	"github.com/gin-gonic/gin"
+)
+
+// exempt lists routes that should never be rate limited, since they're
+// hit by infrastructure (load balancers, scrapers) rather than users.
+var exempt = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// Build returns a new Gin engine with gin.Recovery and the configured
+// optional middleware already attached, in a fixed order: recovery,
+// request logging, CORS, security headers, rate limiting.
+func Build(cfg config.MiddlewareConfig) *gin.Engine {
+	router := gin.New()
+	router.Use(gin.Recovery())
+
+	if cfg.RequestLogging {
+		router.Use(RequestLogger())
+	}
+	if cfg.CORSEnabled {
+		router.Use(CORS(cfg.CORSAllowedOrigins))
+	}
+	if cfg.SecurityHeadersEnabled {
+		router.Use(SecurityHeaders())
+	}
+	if cfg.RateLimitEnabled {
+		limiter := NewIPRateLimiter(cfg.RateLimitRPS, cfg.RateLimitBurst, cfg.RateLimitIdleTTL)
+		router.Use(RateLimit(limiter, exempt))
+	}
+
+	return router
+}
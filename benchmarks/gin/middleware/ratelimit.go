@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// IPRateLimiter hands out a token-bucket limiter per client IP, evicting
+// limiters that have been idle longer than idleTTL.
+type IPRateLimiter struct {
+	mu       sync.Mutex
+	limiters map[string]*ipLimiter
+	rps      rate.Limit
+	burst    int
+	idleTTL  time.Duration
+}
+
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewIPRateLimiter creates a limiter allowing rps requests per second per
+// IP, with the given burst size. idleTTL controls how long an IP's bucket
+// is retained after its last request.
+func NewIPRateLimiter(rps float64, burst int, idleTTL time.Duration) *IPRateLimiter {
+	if idleTTL <= 0 {
+		idleTTL = 5 * time.Minute
+	}
+	return &IPRateLimiter{
+		limiters: make(map[string]*ipLimiter),
+		rps:      rate.Limit(rps),
+		burst:    burst,
+		idleTTL:  idleTTL,
+	}
+}
+
+// Allow reports whether a request from ip should be let through, creating
+// a limiter for previously-unseen IPs on demand.
+func (l *IPRateLimiter) Allow(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	entry, ok := l.limiters[ip]
+	if !ok {
+		entry = &ipLimiter{limiter: rate.NewLimiter(l.rps, l.burst)}
+		l.limiters[ip] = entry
+	}
+	entry.lastSeen = now
+
+	l.evictLocked(now)
+
+	return entry.limiter.Allow()
+}
+
+// evictLocked removes limiters idle longer than idleTTL. Callers must
+// hold l.mu.
+func (l *IPRateLimiter) evictLocked(now time.Time) {
+	for ip, entry := range l.limiters {
+		if now.Sub(entry.lastSeen) > l.idleTTL {
+			delete(l.limiters, ip)
+		}
+	}
+}
+
+// RateLimit returns a middleware that rejects requests exceeding limiter's
+// per-IP rate with 429, skipping any route listed in exemptRoutes.
+func RateLimit(limiter *IPRateLimiter, exemptRoutes map[string]bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if exemptRoutes[c.Request.URL.Path] {
+			c.Next()
+			return
+		}
+
+		if !limiter.Allow(c.ClientIP()) {
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error": "rate limit exceeded",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
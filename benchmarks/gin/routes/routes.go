@@ -0,0 +1,31 @@
+// Package routes declares the server's documented API routes once, so
+// both main and apidoc/cmd/gendoc build the same OpenAPI spec from the
+// same source.
+package routes
+
+import (
+	"net/http"
+
+	"github.com/DavidLandup0/mitsuki/benchmarks/gin/apidoc"
+	"github.com/gin-gonic/gin"
+)
+
+// HelloResponse is the body returned by GET /.
+type HelloResponse struct {
+	Message string `json:"message"`
+}
+
+// Register builds the documented API against router and returns the
+// apidoc.API so the caller can mount /openapi.json and /docs.
+func Register(router gin.IRouter) *apidoc.API {
+	api := apidoc.New(router, "mitsuki gin benchmark", "1.0.0")
+
+	api.GET("/").
+		Summary("Say hello").
+		Response(http.StatusOK, HelloResponse{}).
+		Handle(func(c *gin.Context) {
+			c.JSON(http.StatusOK, HelloResponse{Message: "Hello, World!"})
+		})
+
+	return api
+}
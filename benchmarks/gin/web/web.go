@@ -0,0 +1,159 @@
+// Package web serves the HTML templates and static assets for the gin
+// benchmark server. In release builds, templates and assets are embedded
+// into the binary with go:embed. In debug mode they're read from disk and
+// watched with fsnotify so template edits show up without a restart.
+package web
+
+import (
+	"crypto/rand"
+	"embed"
+	"encoding/base64"
+	"html/template"
+	"io/fs"
+	"log"
+	"net/http"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed templates/*.html
+var embeddedTemplates embed.FS
+
+//go:embed static
+var embeddedStatic embed.FS
+
+// Options configures template loading, static serving, and the context
+// RenderHTML injects into every page.
+type Options struct {
+	// Debug enables loading templates/static assets from disk and hot
+	// reloading on change, instead of using the embedded copies.
+	Debug bool
+
+	// TemplatesDir and StaticDir are only used when Debug is true.
+	TemplatesDir string
+	StaticDir    string
+
+	// BuildInfo is surfaced to templates, e.g. a version string or commit SHA.
+	BuildInfo string
+}
+
+// Register mounts HTML templates and static assets on router, plus a
+// NoRoute fallback that serves index.html for extensionless paths (so a
+// client-side router can own sub-routes of the SPA).
+func Register(router *gin.Engine, opts Options) error {
+	if opts.Debug {
+		if err := loadTemplatesFromDisk(router, opts.TemplatesDir); err != nil {
+			return err
+		}
+		router.Static("/static", opts.StaticDir)
+
+		go watchTemplates(router, opts.TemplatesDir)
+	} else {
+		tmpl, err := template.ParseFS(embeddedTemplates, "templates/*.html")
+		if err != nil {
+			return err
+		}
+		router.SetHTMLTemplate(tmpl)
+
+		staticFS, err := fs.Sub(embeddedStatic, "static")
+		if err != nil {
+			return err
+		}
+		router.StaticFS("/static", http.FS(staticFS))
+	}
+
+	router.NoRoute(func(c *gin.Context) {
+		if filepath.Ext(c.Request.URL.Path) != "" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		RenderHTML(c, "index.html", gin.H{}, opts)
+	})
+
+	return nil
+}
+
+func loadTemplatesFromDisk(router *gin.Engine, dir string) error {
+	tmpl, err := template.ParseGlob(filepath.Join(dir, "*.html"))
+	if err != nil {
+		return err
+	}
+	router.SetHTMLTemplate(tmpl)
+	return nil
+}
+
+// watchTemplates re-parses the template directory whenever a file in it
+// changes, logging (rather than failing the server) on parse errors.
+func watchTemplates(router *gin.Engine, dir string) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Printf("web: starting template watcher: %v", err)
+		return
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(dir); err != nil {
+		log.Printf("web: watching %s: %v", dir, err)
+		return
+	}
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove|fsnotify.Rename) == 0 {
+				continue
+			}
+			if err := loadTemplatesFromDisk(router, dir); err != nil {
+				log.Printf("web: reloading templates: %v", err)
+				continue
+			}
+			log.Printf("web: reloaded templates after change to %s", event.Name)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Printf("web: template watcher error: %v", err)
+		}
+	}
+}
+
+// RenderHTML renders the named template with data merged into common
+// context: the request ID (as set by the request-logging middleware),
+// build info, and a CSRF token (reused from the request's cookie, or newly
+// issued when there is none).
+func RenderHTML(c *gin.Context, name string, data gin.H, opts Options) {
+	if data == nil {
+		data = gin.H{}
+	}
+
+	if requestID, ok := c.Get("request_id"); ok {
+		data["RequestID"] = requestID
+	}
+	data["BuildInfo"] = opts.BuildInfo
+	data["CSRFToken"] = issueCSRFToken(c)
+
+	c.HTML(http.StatusOK, name, data)
+}
+
+const csrfCookieName = "csrf_token"
+
+// issueCSRFToken returns the CSRF token for this session, reusing the one
+// in the request's cookie or minting and setting a new one.
+func issueCSRFToken(c *gin.Context) string {
+	if token, err := c.Cookie(csrfCookieName); err == nil && token != "" {
+		return token
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return ""
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	c.SetCookie(csrfCookieName, token, 0, "/", "", c.Request.TLS != nil, true)
+	return token
+}